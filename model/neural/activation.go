@@ -0,0 +1,140 @@
+package neural
+
+import (
+	// sys import
+	"math"
+
+	// third part import
+	log "github.com/sirupsen/logrus"
+)
+
+// Activation represents an activation function and its derivative, pluggable on a Neuron to
+// replace the raw step behavior used by the original perceptron.
+type Activation interface {
+
+	// Apply computes the activation value for a given pre-activation input z.
+	Apply(z float64) float64
+	// Derivative computes the derivative of the activation function at a given pre-activation input z.
+	Derivative(z float64) float64
+
+}
+
+// StepActivation represents the original hard threshold activation: 1.0 if z >= 0, 0.0 otherwise.
+type StepActivation struct{}
+
+// Apply returns 1.0 if z is non-negative, 0.0 otherwise.
+func (StepActivation) Apply(z float64) float64 {
+	if z < 0.0 {
+		return 0.0
+	}
+	return 1.0
+}
+
+// Derivative returns 1.0 for every z, since the step function is treated as having unit
+// derivative so existing callers relying on the raw error term keep their original behavior.
+func (StepActivation) Derivative(z float64) float64 {
+	return 1.0
+}
+
+// SigmoidActivation represents the logistic sigmoid activation function.
+type SigmoidActivation struct{}
+
+// Apply computes the logistic sigmoid of z.
+func (SigmoidActivation) Apply(z float64) float64 {
+	return 1.0 / (1.0 + math.Exp(-z))
+}
+
+// Derivative computes the derivative of the logistic sigmoid at z.
+func (s SigmoidActivation) Derivative(z float64) float64 {
+	value := s.Apply(z)
+	return value * (1.0 - value)
+}
+
+// TanhActivation represents the hyperbolic tangent activation function.
+type TanhActivation struct{}
+
+// Apply computes the hyperbolic tangent of z.
+func (TanhActivation) Apply(z float64) float64 {
+	return math.Tanh(z)
+}
+
+// Derivative computes the derivative of the hyperbolic tangent at z.
+func (t TanhActivation) Derivative(z float64) float64 {
+	value := t.Apply(z)
+	return 1.0 - value*value
+}
+
+// ReLUActivation represents the rectified linear unit activation function.
+type ReLUActivation struct{}
+
+// Apply returns z if z is positive, 0.0 otherwise.
+func (ReLUActivation) Apply(z float64) float64 {
+	if z < 0.0 {
+		return 0.0
+	}
+	return z
+}
+
+// Derivative returns 1.0 if z is non-negative, 0.0 otherwise.
+func (ReLUActivation) Derivative(z float64) float64 {
+	if z < 0.0 {
+		return 0.0
+	}
+	return 1.0
+}
+
+// LeakyReLUActivation represents the leaky rectified linear unit activation function, with a
+// fixed negative slope of 0.01.
+type LeakyReLUActivation struct{}
+
+// leakyReLUSlope represents the slope applied to negative pre-activation values.
+const leakyReLUSlope = 0.01
+
+// Apply returns z if z is positive, leakyReLUSlope*z otherwise.
+func (LeakyReLUActivation) Apply(z float64) float64 {
+	if z < 0.0 {
+		return leakyReLUSlope * z
+	}
+	return z
+}
+
+// Derivative returns 1.0 if z is non-negative, leakyReLUSlope otherwise.
+func (LeakyReLUActivation) Derivative(z float64) float64 {
+	if z < 0.0 {
+		return leakyReLUSlope
+	}
+	return 1.0
+}
+
+// SoftmaxActivation represents the softmax activation function, applied over a whole vector
+// rather than elementwise. Softmax has no well-defined per-unit value or derivative, so it
+// is not safe to assign to Neuron.Activation: Apply/Derivative exist only to satisfy the
+// Activation interface and deliberately fail loud rather than silently returning a
+// plausible-looking but meaningless result. No vector-form entry point is exposed here: the
+// package has no multi-unit output consumer yet, so shipping one would be dead code.
+type SoftmaxActivation struct{}
+
+// Apply is not a valid per-unit operation for softmax: it logs an error and returns
+// math.NaN() so a Neuron misconfigured with Activation: SoftmaxActivation{} fails visibly
+// instead of silently predicting its raw, unnormalized net input.
+func (SoftmaxActivation) Apply(z float64) float64 {
+	log.WithFields(log.Fields{
+		"level": "error",
+		"place": "activation",
+		"func":  "SoftmaxActivation.Apply",
+		"msg":   "softmax has no valid per-unit value",
+	}).Error("Invalid per-unit use of SoftmaxActivation.")
+	return math.NaN()
+}
+
+// Derivative is not a valid per-unit operation for softmax: it logs an error and returns
+// math.NaN() for the same reason Apply does.
+func (SoftmaxActivation) Derivative(z float64) float64 {
+	log.WithFields(log.Fields{
+		"level": "error",
+		"place": "activation",
+		"func":  "SoftmaxActivation.Derivative",
+		"msg":   "softmax has no valid per-unit derivative",
+	}).Error("Invalid per-unit use of SoftmaxActivation.")
+	return math.NaN()
+}
@@ -0,0 +1,27 @@
+package neural
+
+import "testing"
+
+// TestTrainNeuronBatchConvergesWithSigmoidActivation verifies that a Neuron configured with
+// SigmoidActivation (driving both PredictRaw and the Activation.Derivative term in
+// TrainNeuronBatch) still learns the AND gate, proving the activation-aware gradient path actually
+// trains rather than just compiling.
+func TestTrainNeuronBatchConvergesWithSigmoidActivation(t *testing.T) {
+
+	stimuli := andGateStimuli()
+
+	neuron := Neuron{Weights: make([]float64, 2), Bias: 0.0, Activation: SigmoidActivation{}}
+	TrainNeuronBatch(&neuron, stimuli, TrainParams{
+		NumEpochs:     2000,
+		MiniBatchSize: 1,
+		LearningRate:  2.0,
+	})
+
+	for _, stimulus := range stimuli {
+		predicted := Predict(&neuron, &stimulus)
+		if predicted != stimulus.Expected {
+			t.Errorf("AND(%v) = %v, want %v", stimulus.Dimensions, predicted, stimulus.Expected)
+		}
+	}
+
+}
@@ -0,0 +1,183 @@
+package neural
+
+import (
+	// sys import
+	"math"
+	"time"
+)
+
+// Loss represents a cost function used to drive training, decoupling the training loop from
+// the specific error metric being minimized.
+type Loss interface {
+
+	// Value computes the scalar loss between a predicted and an expected vector.
+	Value(predicted, expected []float64) float64
+	// Gradient computes, per output unit, the error term used to drive weight updates.
+	// By convention it follows the sign of (expected - predicted), matching the perceptron
+	// update rule already used throughout this package.
+	Gradient(predicted, expected []float64) []float64
+
+}
+
+// MSELoss represents the mean squared error loss, the implicit loss used by the original
+// perceptron update rule.
+type MSELoss struct{}
+
+// Value computes the mean squared error between predicted and expected.
+func (MSELoss) Value(predicted, expected []float64) float64 {
+
+	var sum float64 = 0.0
+	for index := range predicted {
+		diff := expected[index] - predicted[index]
+		sum = sum + diff*diff
+	}
+
+	return sum / float64(len(predicted))
+
+}
+
+// Gradient returns, per output unit, expected-predicted: the negative derivative of the mean
+// squared error with respect to predicted (up to the constant factor 2/n, absorbed into the
+// learning rate), identical to the hardcoded error term the original UpdateWeights used.
+func (MSELoss) Gradient(predicted, expected []float64) []float64 {
+
+	gradient := make([]float64, len(predicted))
+	for index := range predicted {
+		gradient[index] = expected[index] - predicted[index]
+	}
+
+	return gradient
+
+}
+
+// BinaryCrossEntropyLoss represents the binary cross-entropy loss, suited to a single
+// sigmoid-activated output unit predicting a probability in (0, 1).
+type BinaryCrossEntropyLoss struct{}
+
+// bceEpsilon clamps predicted probabilities away from 0 and 1 to keep Value finite.
+const bceEpsilon = 1e-12
+
+// Value computes the binary cross-entropy between predicted and expected.
+func (BinaryCrossEntropyLoss) Value(predicted, expected []float64) float64 {
+
+	var sum float64 = 0.0
+	for index := range predicted {
+		p := math.Min(math.Max(predicted[index], bceEpsilon), 1.0-bceEpsilon)
+		sum = sum + expected[index]*math.Log(p) + (1.0-expected[index])*math.Log(1.0-p)
+	}
+
+	return -sum / float64(len(predicted))
+
+}
+
+// Gradient returns, per output unit, the negative derivative of the binary cross-entropy with
+// respect to the continuous predicted probability: (expected-predicted) / (predicted *
+// (1-predicted)), clamped away from 0 and 1. Unlike MSELoss, this weighs the raw
+// expected-predicted error inversely to the predicted probability's confidence, so a confidently
+// wrong prediction produces a much larger error signal than an unconfident one.
+func (BinaryCrossEntropyLoss) Gradient(predicted, expected []float64) []float64 {
+
+	gradient := make([]float64, len(predicted))
+	for index := range predicted {
+		p := math.Min(math.Max(predicted[index], bceEpsilon), 1.0-bceEpsilon)
+		gradient[index] = (expected[index] - predicted[index]) / (p * (1.0 - p))
+	}
+
+	return gradient
+
+}
+
+// resolveLoss returns loss if not nil, MSELoss{} otherwise, so callers that never set a Loss
+// keep the original hardcoded training behavior.
+func resolveLoss(loss Loss) Loss {
+	if loss == nil {
+		return MSELoss{}
+	}
+	return loss
+}
+
+// TrainHistory records per-epoch metrics gathered while training, so callers can inspect or
+// plot convergence without parsing logs.
+type TrainHistory struct {
+
+	// PerEpochLoss holds the training loss reached at the end of each epoch
+	PerEpochLoss []float64
+	// PerEpochAccuracy holds the validation accuracy reached at the end of each epoch,
+	// empty if no validation split was configured
+	PerEpochAccuracy []float64
+	// Duration holds the wall-clock time spent training
+	Duration time.Duration
+
+}
+
+// splitValidation splits stimuli into a training and a validation slice according to
+// fraction (the share of stimuli held out for validation). A fraction <= 0 returns the
+// whole slice as the training set and no validation set.
+func splitValidation(stimuli []Stimulus, fraction float64) ([]Stimulus, []Stimulus) {
+
+	if fraction <= 0.0 {
+		return stimuli, nil
+	}
+
+	validationSize := int(float64(len(stimuli)) * fraction)
+	if validationSize <= 0 {
+		return stimuli, nil
+	}
+
+	splitIndex := len(stimuli) - validationSize
+	return stimuli[:splitIndex], stimuli[splitIndex:]
+
+}
+
+// TrainNeuronWithHistory trains neuron exactly as TrainNeuronBatch does, additionally
+// splitting off params.ValidationFraction of stimuli (when > 0) to track per-epoch validation
+// accuracy, and returns a TrainHistory with per-epoch loss, per-epoch validation accuracy and
+// the total wall-clock duration spent training.
+func TrainNeuronWithHistory(neuron *Neuron, stimuli []Stimulus, params TrainParams) TrainHistory {
+
+	start := time.Now()
+
+	trainStimuli, validationStimuli := splitValidation(stimuli, params.ValidationFraction)
+	loss := resolveLoss(params.Loss)
+	neuron.Loss = loss
+
+	history := TrainHistory{}
+
+	epochParams := params
+	epochParams.NumEpochs = 1
+	epochParams.EpochCallback = nil
+
+	for epoch := 0; epoch < params.NumEpochs; epoch++ {
+
+		TrainNeuronBatch(neuron, trainStimuli, epochParams)
+
+		var epochLoss float64 = 0.0
+		for _, stimulus := range trainStimuli {
+			predicted := PredictRaw(neuron, &stimulus)
+			epochLoss = epochLoss + loss.Value([]float64{predicted}, []float64{stimulus.Expected})
+		}
+		epochLoss = epochLoss / float64(len(trainStimuli))
+		history.PerEpochLoss = append(history.PerEpochLoss, epochLoss)
+
+		if len(validationStimuli) > 0 {
+			actual := make([]float64, len(validationStimuli))
+			predicted := make([]float64, len(validationStimuli))
+			for index, stimulus := range validationStimuli {
+				actual[index] = stimulus.Expected
+				predicted[index] = Predict(neuron, &stimulus)
+			}
+			_, accuracy := Accuracy(actual, predicted)
+			history.PerEpochAccuracy = append(history.PerEpochAccuracy, accuracy)
+		}
+
+		if params.EpochCallback != nil {
+			params.EpochCallback(epoch, epochLoss)
+		}
+
+	}
+
+	history.Duration = time.Since(start)
+
+	return history
+
+}
@@ -0,0 +1,50 @@
+package neural
+
+import "testing"
+
+// TestTrainNeuronBatchConvergesWithBinaryCrossEntropyLoss verifies that a Neuron trained with
+// BinaryCrossEntropyLoss, properly paired with SigmoidActivation so its gradient is fed a
+// genuine (0, 1) probability, learns the AND gate.
+func TestTrainNeuronBatchConvergesWithBinaryCrossEntropyLoss(t *testing.T) {
+
+	stimuli := andGateStimuli()
+
+	neuron := Neuron{Weights: make([]float64, 2), Bias: 0.0, Activation: SigmoidActivation{}}
+	TrainNeuronBatch(&neuron, stimuli, TrainParams{
+		NumEpochs:     2000,
+		MiniBatchSize: 1,
+		LearningRate:  0.5,
+		Loss:          BinaryCrossEntropyLoss{},
+	})
+
+	for _, stimulus := range stimuli {
+		predicted := Predict(&neuron, &stimulus)
+		if predicted != stimulus.Expected {
+			t.Errorf("AND(%v) = %v, want %v", stimulus.Dimensions, predicted, stimulus.Expected)
+		}
+	}
+
+}
+
+// TestTrainNeuronBatchConvergesWithMSELoss verifies that a Neuron trained with MSELoss (the
+// default loss, exercised explicitly here rather than left implicit) also learns the AND gate.
+func TestTrainNeuronBatchConvergesWithMSELoss(t *testing.T) {
+
+	stimuli := andGateStimuli()
+
+	neuron := Neuron{Weights: make([]float64, 2), Bias: 0.0}
+	TrainNeuronBatch(&neuron, stimuli, TrainParams{
+		NumEpochs:     200,
+		MiniBatchSize: 2,
+		LearningRate:  0.5,
+		Loss:          MSELoss{},
+	})
+
+	for _, stimulus := range stimuli {
+		predicted := Predict(&neuron, &stimulus)
+		if predicted != stimulus.Expected {
+			t.Errorf("AND(%v) = %v, want %v", stimulus.Dimensions, predicted, stimulus.Expected)
+		}
+	}
+
+}
@@ -0,0 +1,352 @@
+package neural
+
+import (
+
+	// sys import
+	"math"
+	"math/rand"
+	"time"
+
+	// third part import
+	log "github.com/sirupsen/logrus"
+	"gonum.org/v1/gonum/mat"
+)
+
+// MLP struct represents a feed-forward multilayer perceptron built on dense matrices,
+// trained through classic backpropagation.
+type MLP struct {
+
+	// layers holds the number of units in each layer, input layer included
+	layers []int
+	// weights holds, for each layer transition, a layers[i+1] x layers[i] matrix
+	weights []*mat.Dense
+	// biases holds, for each layer transition, a layers[i+1] x 1 matrix
+	biases []*mat.Dense
+
+	// activations caches, per layer, the post-activation output computed during Forward
+	activations []*mat.Dense
+	// zs caches, per layer, the pre-activation values computed during Forward
+	zs []*mat.Dense
+
+}
+
+// NewMLP builds a new MLP given the number of units in each layer, input layer included.
+// Weights are initialized using Xavier/He random init, scaled by sqrt(2 / fanIn).
+func NewMLP(layers ...int) *MLP {
+
+	net := &MLP{
+		layers:      layers,
+		weights:     make([]*mat.Dense, len(layers)-1),
+		biases:      make([]*mat.Dense, len(layers)-1),
+		activations: make([]*mat.Dense, len(layers)),
+		zs:          make([]*mat.Dense, len(layers)-1),
+	}
+
+	for index := 0; index < len(layers)-1; index++ {
+
+		fanIn, fanOut := layers[index], layers[index+1]
+		scale := math.Sqrt(2.0 / float64(fanIn))
+
+		weightValues := make([]float64, fanOut*fanIn)
+		for valueIndex := range weightValues {
+			weightValues[valueIndex] = rand.NormFloat64() * scale
+		}
+		net.weights[index] = mat.NewDense(fanOut, fanIn, weightValues)
+
+		net.biases[index] = mat.NewDense(fanOut, 1, make([]float64, fanOut))
+
+	}
+
+	log.WithFields(log.Fields{
+		"level":  "debug",
+		"place":  "mlp",
+		"func":   "NewMLP",
+		"msg":    "new mlp init",
+		"layers": layers,
+	}).Debug()
+
+	return net
+
+}
+
+// Forward propagates input through the network, caching per-layer activations and
+// pre-activation values for later use by Backward. It returns the output layer activation.
+func (net *MLP) Forward(input *mat.Dense) *mat.Dense {
+
+	net.activations[0] = input
+
+	for index, weight := range net.weights {
+
+		var z mat.Dense
+		z.Mul(weight, net.activations[index])
+		z.Add(&z, net.biases[index])
+		net.zs[index] = &z
+
+		activation := mat.NewDense(z.RawMatrix().Rows, 1, nil)
+		activation.Apply(func(r, c int, v float64) float64 {
+			return sigmoid(v)
+		}, &z)
+		net.activations[index+1] = activation
+
+	}
+
+	return net.activations[len(net.activations)-1]
+
+}
+
+// Backward performs one step of classic backpropagation given the target output and a learning
+// rate, using the activations and pre-activation values cached by the previous Forward call.
+// It is a thin wrapper around backwardGradients/applyGradients that applies the computed
+// gradients immediately, for per-sample online training. It returns the mean squared error
+// between prediction and target.
+func (net *MLP) Backward(target *mat.Dense, lr float64) float64 {
+
+	weightGrads, biasGrads, squaredError := net.backwardGradients(target)
+	net.applyGradients(weightGrads, biasGrads, lr)
+
+	return squaredError
+
+}
+
+// backwardGradients computes, for every layer, the weight and bias gradients with respect to
+// target, using the activations and pre-activation values cached by the previous Forward call,
+// without applying them to net.weights/net.biases. The output-layer delta is computed as
+// (a_L - y) elementwise sigmoid'(z_L), propagated backwards as
+// delta_l = (W_{l+1}^T delta_{l+1}) elementwise sigmoid'(z_l), and gradients are
+// delta_l * a_{l-1}^T for weights and delta_l for biases. It also returns the mean squared
+// error between prediction and target, so callers can accumulate gradients across a mini-batch
+// before applying them via applyGradients.
+func (net *MLP) backwardGradients(target *mat.Dense) (weightGrads []*mat.Dense, biasGrads []*mat.Dense, squaredError float64) {
+
+	numLayers := len(net.weights)
+	deltas := make([]*mat.Dense, numLayers)
+
+	output := net.activations[numLayers]
+	var outputError mat.Dense
+	outputError.Sub(output, target)
+
+	sigmoidPrime := mat.NewDense(net.zs[numLayers-1].RawMatrix().Rows, 1, nil)
+	sigmoidPrime.Apply(func(r, c int, v float64) float64 {
+		return sigmoidDerivative(v)
+	}, net.zs[numLayers-1])
+
+	var outputDelta mat.Dense
+	outputDelta.MulElem(&outputError, sigmoidPrime)
+	deltas[numLayers-1] = &outputDelta
+
+	for index := numLayers - 2; index >= 0; index-- {
+
+		var propagated mat.Dense
+		propagated.Mul(net.weights[index+1].T(), deltas[index+1])
+
+		sigmoidPrimeLayer := mat.NewDense(net.zs[index].RawMatrix().Rows, 1, nil)
+		sigmoidPrimeLayer.Apply(func(r, c int, v float64) float64 {
+			return sigmoidDerivative(v)
+		}, net.zs[index])
+
+		var delta mat.Dense
+		delta.MulElem(&propagated, sigmoidPrimeLayer)
+		deltas[index] = &delta
+
+	}
+
+	weightGrads = make([]*mat.Dense, numLayers)
+	biasGrads = make([]*mat.Dense, numLayers)
+
+	for index := 0; index < numLayers; index++ {
+
+		var gradient mat.Dense
+		gradient.Mul(deltas[index], net.activations[index].T())
+		weightGrads[index] = &gradient
+
+		biasGrads[index] = deltas[index]
+
+	}
+
+	rows, _ := outputError.Dims()
+	for row := 0; row < rows; row++ {
+		value := outputError.At(row, 0)
+		squaredError = squaredError + value*value
+	}
+	squaredError = squaredError / float64(rows)
+
+	return weightGrads, biasGrads, squaredError
+
+}
+
+// applyGradients updates net.weights/net.biases as W_l -= lr * weightGrads[l] and
+// b_l -= lr * biasGrads[l], as produced by backwardGradients.
+func (net *MLP) applyGradients(weightGrads []*mat.Dense, biasGrads []*mat.Dense, lr float64) {
+
+	for index := range net.weights {
+
+		var scaledWeightGrad mat.Dense
+		scaledWeightGrad.Scale(lr, weightGrads[index])
+		net.weights[index].Sub(net.weights[index], &scaledWeightGrad)
+
+		var scaledBiasGrad mat.Dense
+		scaledBiasGrad.Scale(lr, biasGrads[index])
+		net.biases[index].Sub(net.biases[index], &scaledBiasGrad)
+
+	}
+
+}
+
+// sigmoid computes the logistic function of z.
+func sigmoid(z float64) float64 {
+	return 1.0 / (1.0 + math.Exp(-z))
+}
+
+// sigmoidDerivative computes the derivative of the logistic function of z.
+func sigmoidDerivative(z float64) float64 {
+	s := sigmoid(z)
+	return s * (1.0 - s)
+}
+
+// stimulusToColumn converts a Stimulus's Dimensions into a column matrix suitable as MLP input.
+func stimulusToColumn(stimulus *Stimulus) *mat.Dense {
+	return mat.NewDense(len(stimulus.Dimensions), 1, append([]float64(nil), stimulus.Dimensions...))
+}
+
+// TrainMLP trains a passed MLP with stimuli passed using the same mini-batch loop as
+// TrainNeuronBatch: at each epoch, stimuli are optionally shuffled and partitioned into
+// mini-batches of params.MiniBatchSize, per-sample gradient matrices are accumulated via
+// Forward/backwardGradients across the whole mini-batch, then applied once as a single
+// averaged update via applyGradients, and params.EpochCallback, when set, is invoked with the
+// mean squared error reached at each epoch.
+func TrainMLP(net *MLP, stimuli []Stimulus, params TrainParams) {
+
+	var numStimuli int = len(stimuli)
+
+	var miniBatchSize int = params.MiniBatchSize
+	if miniBatchSize <= 0 {
+		miniBatchSize = numStimuli
+	}
+
+	numLayers := len(net.weights)
+
+	for epoch := 0; epoch < params.NumEpochs; epoch++ {
+
+		workingStimuli := make([]Stimulus, numStimuli)
+		copy(workingStimuli, stimuli)
+
+		if params.Shuffle {
+			shuffleStimuli(workingStimuli, params.RandSource)
+		}
+
+		var squaredError float64 = 0.0
+
+		for start := 0; start < numStimuli; start += miniBatchSize {
+
+			end := start + miniBatchSize
+			if end > numStimuli {
+				end = numStimuli
+			}
+			batch := workingStimuli[start:end]
+
+			// accumulate gradient matrices across the batch
+			weightGradSums := make([]*mat.Dense, numLayers)
+			biasGradSums := make([]*mat.Dense, numLayers)
+			for index := range net.weights {
+				rows, cols := net.weights[index].Dims()
+				weightGradSums[index] = mat.NewDense(rows, cols, nil)
+				biasRows, biasCols := net.biases[index].Dims()
+				biasGradSums[index] = mat.NewDense(biasRows, biasCols, nil)
+			}
+
+			for _, stimulus := range batch {
+				input := stimulusToColumn(&stimulus)
+				target := mat.NewDense(1, 1, []float64{stimulus.Expected})
+				net.Forward(input)
+
+				weightGrads, biasGrads, sampleError := net.backwardGradients(target)
+				squaredError = squaredError + sampleError
+
+				for index := range weightGradSums {
+					weightGradSums[index].Add(weightGradSums[index], weightGrads[index])
+					biasGradSums[index].Add(biasGradSums[index], biasGrads[index])
+				}
+			}
+
+			// apply the averaged gradients once per batch
+			batchSize := float64(len(batch))
+			for index := range weightGradSums {
+				weightGradSums[index].Scale(1.0/batchSize, weightGradSums[index])
+				biasGradSums[index].Scale(1.0/batchSize, biasGradSums[index])
+			}
+			net.applyGradients(weightGradSums, biasGradSums, params.LearningRate)
+
+		}
+
+		log.WithFields(log.Fields{
+			"level":        "debug",
+			"place":        "error evolution in epoch",
+			"method":       "TrainMLP",
+			"msg":          "epoch and squared error reached after updating weights",
+			"epochReached": epoch + 1,
+			"squaredError": squaredError,
+		}).Debug()
+
+		if params.EpochCallback != nil {
+			params.EpochCallback(epoch, squaredError/float64(numStimuli))
+		}
+
+	}
+
+}
+
+// TrainMLPWithHistory trains net exactly as TrainMLP does, additionally splitting off
+// params.ValidationFraction of stimuli (when > 0) to track per-epoch validation accuracy, and
+// returns a TrainHistory with per-epoch loss, per-epoch validation accuracy and the total
+// wall-clock duration spent training.
+func TrainMLPWithHistory(net *MLP, stimuli []Stimulus, params TrainParams) TrainHistory {
+
+	start := time.Now()
+
+	trainStimuli, validationStimuli := splitValidation(stimuli, params.ValidationFraction)
+
+	history := TrainHistory{}
+
+	epochParams := params
+	epochParams.NumEpochs = 1
+	epochParams.EpochCallback = nil
+
+	for epoch := 0; epoch < params.NumEpochs; epoch++ {
+
+		TrainMLP(net, trainStimuli, epochParams)
+
+		var epochLoss float64 = 0.0
+		for _, stimulus := range trainStimuli {
+			output := net.Forward(stimulusToColumn(&stimulus))
+			epochLoss = epochLoss + resolveLoss(params.Loss).Value([]float64{output.At(0, 0)}, []float64{stimulus.Expected})
+		}
+		epochLoss = epochLoss / float64(len(trainStimuli))
+		history.PerEpochLoss = append(history.PerEpochLoss, epochLoss)
+
+		if len(validationStimuli) > 0 {
+			actual := make([]float64, len(validationStimuli))
+			predicted := make([]float64, len(validationStimuli))
+			for index, stimulus := range validationStimuli {
+				actual[index] = stimulus.Expected
+				output := net.Forward(stimulusToColumn(&stimulus))
+				if output.At(0, 0) < 0.5 {
+					predicted[index] = 0.0
+				} else {
+					predicted[index] = 1.0
+				}
+			}
+			_, accuracy := Accuracy(actual, predicted)
+			history.PerEpochAccuracy = append(history.PerEpochAccuracy, accuracy)
+		}
+
+		if params.EpochCallback != nil {
+			params.EpochCallback(epoch, epochLoss)
+		}
+
+	}
+
+	history.Duration = time.Since(start)
+
+	return history
+
+}
@@ -35,6 +35,13 @@ type Neuron struct {
 	// Delta represents delta error for unit
 	Delta float64
 
+	// Activation represents the activation function applied on prediction and weight update.
+	// If nil, Neuron falls back to the original step behavior for backward compatibility.
+	Activation Activation
+	// Loss represents the cost function whose gradient drives weight updates.
+	// If nil, Neuron falls back to the original hardcoded expected-predicted error term.
+	Loss Loss
+
 }
 
 // #######################################################################################
@@ -75,11 +82,22 @@ func RandomNeuronInit(neuron *Neuron, dim int) {
 
 // UpdateWeights performs update in neuron weights with respect to passed stimulus.
 // It returns error of prediction before and after updating weights.
+// The error term is computed via neuron.Loss.Gradient, evaluated against the continuous
+// PredictRaw output (not the thresholded Predict value) when neuron.Loss is set, falling back
+// to the original hardcoded expected-predicted term otherwise. When neuron.Activation is set,
+// the error term is further multiplied by Activation.Derivative(z) evaluated at the pre-update
+// net input, so the same Neuron can serve as a hidden unit trained by backpropagation rather
+// than the raw perceptron rule.
 func UpdateWeights(neuron *Neuron, stimulus *Stimulus) (float64, float64) {
 
 	// compute prediction value and error for stimulus given neuron BEFORE update (actual state)
-	var predictedValue, prevError, postError float64 = Predict(neuron, stimulus), 0.0, 0.0
-	prevError = stimulus.Expected - predictedValue
+	var predictedValue, prevError, postError float64 = PredictRaw(neuron, stimulus), 0.0, 0.0
+	prevError = resolveLoss(neuron.Loss).Gradient([]float64{predictedValue}, []float64{stimulus.Expected})[0]
+
+	if neuron.Activation != nil {
+		netInput := mu.ScalarProduct(neuron.Weights, stimulus.Dimensions) + neuron.Bias
+		prevError = prevError * neuron.Activation.Derivative(netInput)
+	}
 
 	// performs weights update for neuron
 	neuron.Bias = neuron.Bias + neuron.Lrate*prevError
@@ -90,8 +108,8 @@ func UpdateWeights(neuron *Neuron, stimulus *Stimulus) (float64, float64) {
 	}
 
 	// compute prediction value and error for stimulus given neuron AFTER update (actual state)
-	predictedValue = Predict(neuron, stimulus)
-	postError = stimulus.Expected - predictedValue
+	predictedValue = PredictRaw(neuron, stimulus)
+	postError = resolveLoss(neuron.Loss).Gradient([]float64{predictedValue}, []float64{stimulus.Expected})[0]
 
 	log.WithFields(log.Fields{
 		"level":   "debug",
@@ -106,9 +124,39 @@ func UpdateWeights(neuron *Neuron, stimulus *Stimulus) (float64, float64) {
 
 }
 
+// TrainParams groups the hyperparameters used by TrainNeuronBatch to control
+// mini-batch stochastic gradient descent.
+type TrainParams struct {
+
+	// NumEpochs represents number of epochs used for training
+	NumEpochs int
+	// MiniBatchSize represents the number of stimuli accumulated before weights are updated
+	MiniBatchSize int
+	// LearningRate represents the learning rate applied to accumulated gradients
+	LearningRate float64
+	// RegularizationTerm represents the L2 weight decay term (lambda)
+	RegularizationTerm float64
+	// Shuffle, if true, shuffles stimuli before partitioning into mini-batches at each epoch
+	Shuffle bool
+	// RandSource represents the random source used to shuffle stimuli
+	RandSource *rand.Rand
+	// EpochCallback, if not nil, is invoked after each epoch with the epoch index and loss reached
+	EpochCallback func(epoch int, loss float64)
+	// Loss represents the cost function driving weight updates in TrainNeuronBatch. Nil falls
+	// back to MSELoss, matching the original hardcoded expected-predicted error term. TrainMLP
+	// does not use it: its backpropagation always starts from the squared-error gradient, and
+	// TrainMLPWithHistory uses Loss only to compute the reported per-epoch loss.
+	Loss Loss
+	// ValidationFraction represents the share of stimuli held out for per-epoch validation
+	// accuracy tracking by TrainNeuronWithHistory/TrainMLPWithHistory. Zero disables validation.
+	ValidationFraction float64
+}
+
 // TrainNeuron trains a passed neuron with stimuli passed, for specified number of epoch.
 // If init is 0, leaves weights unchanged before training.
 // If init is 1, reset weights and bias of neuron before training.
+// It is a thin wrapper around TrainNeuronBatch with MiniBatchSize=1, no regularization and
+// neuron.Loss as the training loss, kept for backward compatibility with existing callers.
 func TrainNeuron(neuron *Neuron, stimuli []Stimulus, epochs int, init int) {
 
 	// init weights if specified
@@ -117,51 +165,158 @@ func TrainNeuron(neuron *Neuron, stimuli []Stimulus, epochs int, init int) {
 		neuron.Bias = 0.0
 	}
 
-	// init counter
-	var epoch int = 0
+	TrainNeuronBatch(neuron, stimuli, TrainParams{
+		NumEpochs:          epochs,
+		MiniBatchSize:      1,
+		LearningRate:       neuron.Lrate,
+		RegularizationTerm: 0.0,
+		Shuffle:            false,
+		Loss:               neuron.Loss,
+	})
+
+}
 
-	// accumulator errors prev and post weights updates
-	var squaredPrevError, squaredPostError float64 = 0.0, 0.0
+// TrainNeuronBatch trains a passed neuron with stimuli passed using mini-batch stochastic
+// gradient descent, as configured by params. At each epoch, if params.Shuffle is true, stimuli
+// are shuffled before being partitioned into mini-batches of params.MiniBatchSize. Gradient
+// deltas are accumulated across each mini-batch, then applied as
+// w_i := (1 - lr*lambda/N) * w_i + (lr / batchSize) * sum(error * x_i) and
+// b := b + (lr / batchSize) * sum(error), with L2 weight decay applied to weights only. The
+// error term is the params.Loss gradient evaluated on the PredictRaw output, multiplied by
+// neuron.Activation.Derivative at the net input when neuron.Activation is set.
+// If params.EpochCallback is not nil, it is invoked after each epoch with the epoch index and
+// the mean squared error (expected - PredictRaw output) over the whole training set.
+func TrainNeuronBatch(neuron *Neuron, stimuli []Stimulus, params TrainParams) {
+
+	// number of training examples, used for L2 weight decay normalization
+	var numStimuli int = len(stimuli)
+
+	// mini-batch size defaults to the whole training set when not specified
+	var miniBatchSize int = params.MiniBatchSize
+	if miniBatchSize <= 0 {
+		miniBatchSize = numStimuli
+	}
+
+	// random source used to shuffle stimuli, defaults to the package-level source
+	var randSource *rand.Rand = params.RandSource
+
+	// resolve the loss driving weight updates, defaulting to MSELoss
+	loss := resolveLoss(params.Loss)
 
 	// in each epoch
-	for epoch < epochs {
-
-		// update weight using each stimulus in training set
-		for _, stimulus := range stimuli {
-			prevError, postError := UpdateWeights(neuron, &stimulus)
-			// NOTE: in each step, use weights already updated by previous
-			squaredPrevError = squaredPrevError + (prevError * prevError)
-			squaredPostError = squaredPostError + (postError * postError)
+	for epoch := 0; epoch < params.NumEpochs; epoch++ {
+
+		// working copy of stimuli, shuffled in place when requested
+		workingStimuli := make([]Stimulus, numStimuli)
+		copy(workingStimuli, stimuli)
+
+		if params.Shuffle {
+			shuffleStimuli(workingStimuli, randSource)
+		}
+
+		// accumulator for mean squared error over the whole epoch
+		var squaredError float64 = 0.0
+
+		// partition working stimuli into mini-batches and apply one update per batch
+		for start := 0; start < numStimuli; start += miniBatchSize {
+
+			end := start + miniBatchSize
+			if end > numStimuli {
+				end = numStimuli
+			}
+			batch := workingStimuli[start:end]
+
+			// accumulate gradient deltas across the batch
+			weightDeltas := make([]float64, len(neuron.Weights))
+			var biasDelta float64 = 0.0
+
+			for _, stimulus := range batch {
+				predictedValue := PredictRaw(neuron, &stimulus)
+				rawError := stimulus.Expected - predictedValue
+				squaredError = squaredError + (rawError * rawError)
+
+				stimulusError := loss.Gradient([]float64{predictedValue}, []float64{stimulus.Expected})[0]
+				if neuron.Activation != nil {
+					netInput := mu.ScalarProduct(neuron.Weights, stimulus.Dimensions) + neuron.Bias
+					stimulusError = stimulusError * neuron.Activation.Derivative(netInput)
+				}
+
+				for index := range neuron.Weights {
+					weightDeltas[index] = weightDeltas[index] + stimulusError*stimulus.Dimensions[index]
+				}
+				biasDelta = biasDelta + stimulusError
+			}
+
+			// apply accumulated updates, with L2 weight decay on weights
+			batchSize := float64(len(batch))
+			decay := 1.0 - params.LearningRate*params.RegularizationTerm/float64(numStimuli)
+			for index := range neuron.Weights {
+				neuron.Weights[index] = decay*neuron.Weights[index] + (params.LearningRate/batchSize)*weightDeltas[index]
+			}
+			neuron.Bias = neuron.Bias + (params.LearningRate/batchSize)*biasDelta
+
 		}
 
 		log.WithFields(log.Fields{
-			"level":            "debug",
-			"place":            "error evolution in epoch",
-			"method":           "TrainNeuron",
-			"msg":              "epoch and squared errors reached before and after updating weights",
-			"epochReached":     epoch + 1,
-			"squaredErrorPrev": squaredPrevError,
-			"squaredErrorPost": squaredPostError,
+			"level":        "debug",
+			"place":        "error evolution in epoch",
+			"method":       "TrainNeuronBatch",
+			"msg":          "epoch and squared error reached after updating weights",
+			"epochReached": epoch + 1,
+			"squaredError": squaredError,
 		}).Debug()
 
-		// increment epoch counter
-		epoch++
+		if params.EpochCallback != nil {
+			params.EpochCallback(epoch, squaredError/float64(numStimuli))
+		}
+
+	}
 
+}
+
+// shuffleStimuli randomly permutes stimuli in place using the Fisher-Yates algorithm.
+// If source is nil, the package-level random source is used.
+func shuffleStimuli(stimuli []Stimulus, source *rand.Rand) {
+
+	for index := len(stimuli) - 1; index > 0; index-- {
+		var swapIndex int
+		if source != nil {
+			swapIndex = source.Intn(index + 1)
+		} else {
+			swapIndex = rand.Intn(index + 1)
+		}
+		stimuli[index], stimuli[swapIndex] = stimuli[swapIndex], stimuli[index]
 	}
 
 }
 
 // Predict performs a neuron prediction to passed stimulus.
-// It returns a float64 binary predicted value.
+// It returns a float64 binary predicted value, thresholding the PredictRaw output at 0.5.
 func Predict(neuron *Neuron, stimulus *Stimulus) float64 {
 
-	if mu.ScalarProduct(neuron.Weights, stimulus.Dimensions)+neuron.Bias < 0.0 {
+	if PredictRaw(neuron, stimulus) < 0.5 {
 		return 0.0
 	}
 	return 1.0
 
 }
 
+// PredictRaw performs a neuron prediction to passed stimulus and returns the continuous
+// post-activation value, for probabilistic downstream use. If neuron.Activation is nil, the
+// original step behavior is used (StepActivation), so the raw perceptron rule is preserved:
+// the net input is thresholded to 0.0/1.0, never returned unbounded.
+func PredictRaw(neuron *Neuron, stimulus *Stimulus) float64 {
+
+	netInput := mu.ScalarProduct(neuron.Weights, stimulus.Dimensions) + neuron.Bias
+
+	if neuron.Activation == nil {
+		return StepActivation{}.Apply(netInput)
+	}
+
+	return neuron.Activation.Apply(netInput)
+
+}
+
 // Accuracy calculate percentage of equal values between two float64 based slices.
 // It returns int number and a float64 percentage value of corrected values.
 func Accuracy(actual []float64, predicted []float64) (int, float64) {
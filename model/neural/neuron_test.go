@@ -0,0 +1,54 @@
+package neural
+
+import "testing"
+
+// andGateStimuli returns the classic linearly-separable AND-gate training set.
+func andGateStimuli() []Stimulus {
+	return []Stimulus{
+		{Dimensions: []float64{0.0, 0.0}, Expected: 0.0},
+		{Dimensions: []float64{0.0, 1.0}, Expected: 0.0},
+		{Dimensions: []float64{1.0, 0.0}, Expected: 0.0},
+		{Dimensions: []float64{1.0, 1.0}, Expected: 1.0},
+	}
+}
+
+// TestTrainNeuronConvergesOnAND verifies that TrainNeuron (the backward-compatible wrapper
+// around TrainNeuronBatch) still learns the AND gate with the original perceptron rule.
+func TestTrainNeuronConvergesOnAND(t *testing.T) {
+
+	stimuli := andGateStimuli()
+
+	neuron := Neuron{Lrate: 0.1}
+	TrainNeuron(&neuron, stimuli, 50, 1)
+
+	for _, stimulus := range stimuli {
+		predicted := Predict(&neuron, &stimulus)
+		if predicted != stimulus.Expected {
+			t.Errorf("AND(%v) = %v, want %v", stimulus.Dimensions, predicted, stimulus.Expected)
+		}
+	}
+
+}
+
+// TestTrainNeuronBatchConvergesOnAND verifies that TrainNeuronBatch learns the AND gate with
+// mini-batch sizes greater than 1, where the original per-sample TrainNeuron never exercised
+// batch accumulation at all.
+func TestTrainNeuronBatchConvergesOnAND(t *testing.T) {
+
+	stimuli := andGateStimuli()
+
+	neuron := Neuron{Weights: make([]float64, 2), Bias: 0.0}
+	TrainNeuronBatch(&neuron, stimuli, TrainParams{
+		NumEpochs:     200,
+		MiniBatchSize: 2,
+		LearningRate:  0.5,
+	})
+
+	for _, stimulus := range stimuli {
+		predicted := Predict(&neuron, &stimulus)
+		if predicted != stimulus.Expected {
+			t.Errorf("AND(%v) = %v, want %v", stimulus.Dimensions, predicted, stimulus.Expected)
+		}
+	}
+
+}
@@ -0,0 +1,12 @@
+package neural
+
+// Stimulus struct represents one training or testing example: an input vector paired with its
+// desired output value.
+type Stimulus struct {
+
+	// Dimensions represents the input feature vector
+	Dimensions []float64
+	// Expected represents the desired output value for Dimensions
+	Expected float64
+
+}
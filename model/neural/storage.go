@@ -0,0 +1,305 @@
+package neural
+
+import (
+
+	// sys import
+	"bytes"
+	"encoding/gob"
+	"fmt"
+	"os"
+
+	// third part import
+	log "github.com/sirupsen/logrus"
+	"gonum.org/v1/gonum/mat"
+)
+
+// modelMagic identifies a gob-encoded model payload produced by SaveModel.
+const modelMagic = "GOPERCEPTRON"
+
+// modelVersion1 is the semver integer of the current NeuronModelV1/MLPModelV1 wire format.
+const modelVersion1 = 1
+
+func init() {
+	// register concrete activation types so they can be gob-encoded/decoded when embedded
+	// inside larger payloads (e.g. Neuron.Activation, interface{} passed to SaveModel)
+	gob.Register(StepActivation{})
+	gob.Register(SigmoidActivation{})
+	gob.Register(TanhActivation{})
+	gob.Register(ReLUActivation{})
+	gob.Register(LeakyReLUActivation{})
+	gob.Register(SoftmaxActivation{})
+
+	// register concrete loss types for the same reason
+	gob.Register(MSELoss{})
+	gob.Register(BinaryCrossEntropyLoss{})
+
+	// register the concrete model types SaveModel/LoadModel round-trip through interface{}
+	gob.Register(&Neuron{})
+	gob.Register(&MLP{})
+}
+
+// activationName resolves an Activation to the string identifier stored in a versioned model,
+// so it can be resolved back to a concrete type on load without gob-encoding the interface
+// itself. It returns an empty string for a nil Activation.
+func activationName(activation Activation) string {
+
+	switch activation.(type) {
+	case StepActivation:
+		return "step"
+	case SigmoidActivation:
+		return "sigmoid"
+	case TanhActivation:
+		return "tanh"
+	case ReLUActivation:
+		return "relu"
+	case LeakyReLUActivation:
+		return "leakyrelu"
+	case SoftmaxActivation:
+		return "softmax"
+	default:
+		return ""
+	}
+
+}
+
+// activationByName resolves a string identifier stored in a versioned model back to a
+// concrete Activation. It returns nil for an empty identifier or one it does not recognize.
+func activationByName(name string) Activation {
+
+	switch name {
+	case "step":
+		return StepActivation{}
+	case "sigmoid":
+		return SigmoidActivation{}
+	case "tanh":
+		return TanhActivation{}
+	case "relu":
+		return ReLUActivation{}
+	case "leakyrelu":
+		return LeakyReLUActivation{}
+	case "softmax":
+		return SoftmaxActivation{}
+	default:
+		return nil
+	}
+
+}
+
+// lossName resolves a Loss to the string identifier stored in a versioned model, so it can be
+// resolved back to a concrete type on load without gob-encoding the interface itself. It
+// returns an empty string for a nil Loss.
+func lossName(loss Loss) string {
+
+	switch loss.(type) {
+	case MSELoss:
+		return "mse"
+	case BinaryCrossEntropyLoss:
+		return "binarycrossentropy"
+	default:
+		return ""
+	}
+
+}
+
+// lossByName resolves a string identifier stored in a versioned model back to a concrete
+// Loss. It returns nil for an empty identifier or one it does not recognize.
+func lossByName(name string) Loss {
+
+	switch name {
+	case "mse":
+		return MSELoss{}
+	case "binarycrossentropy":
+		return BinaryCrossEntropyLoss{}
+	default:
+		return nil
+	}
+
+}
+
+// NeuronModelV1 is the versioned, gob-encodable wire representation of a trained Neuron.
+type NeuronModelV1 struct {
+
+	// Magic identifies the payload as a go-perceptron-go model
+	Magic string
+	// Version is the semver integer of this wire format
+	Version int
+
+	// Weights holds the neuron weights
+	Weights []float64
+	// Bias holds the neuron bias
+	Bias float64
+	// Lrate holds the neuron learning rate
+	Lrate float64
+	// ActivationName holds the string identifier of the neuron activation, empty if nil
+
+	ActivationName string
+	// LossName holds the string identifier of the neuron loss, empty if nil
+	LossName string
+
+}
+
+// MLPModelV1 is the versioned, gob-encodable wire representation of a trained MLP.
+type MLPModelV1 struct {
+
+	// Magic identifies the payload as a go-perceptron-go model
+	Magic string
+	// Version is the semver integer of this wire format
+	Version int
+
+	// Layers holds the number of units in each layer, input layer included
+	Layers []int
+	// Weights holds, for each layer transition, the flattened row-major weight matrix
+	Weights [][]float64
+	// Biases holds, for each layer transition, the flattened bias vector
+	Biases [][]float64
+
+}
+
+// MarshalBinary encodes neuron into its versioned gob wire representation.
+func (neuron *Neuron) MarshalBinary() ([]byte, error) {
+
+	model := NeuronModelV1{
+		Magic:          modelMagic,
+		Version:        modelVersion1,
+		Weights:        neuron.Weights,
+		Bias:           neuron.Bias,
+		Lrate:          neuron.Lrate,
+		ActivationName: activationName(neuron.Activation),
+		LossName:       lossName(neuron.Loss),
+	}
+
+	var buffer bytes.Buffer
+	if err := gob.NewEncoder(&buffer).Encode(model); err != nil {
+		return nil, err
+	}
+
+	return buffer.Bytes(), nil
+
+}
+
+// UnmarshalBinary decodes a versioned gob wire representation produced by MarshalBinary
+// into neuron, replacing its weights, bias, learning rate, activation and loss.
+func (neuron *Neuron) UnmarshalBinary(data []byte) error {
+
+	var model NeuronModelV1
+	if err := gob.NewDecoder(bytes.NewReader(data)).Decode(&model); err != nil {
+		return err
+	}
+	if model.Magic != modelMagic {
+		return fmt.Errorf("neural: not a go-perceptron-go model, magic mismatch: %q", model.Magic)
+	}
+	if model.Version != modelVersion1 {
+		return fmt.Errorf("neural: unsupported neuron model version: %d", model.Version)
+	}
+
+	neuron.Weights = model.Weights
+	neuron.Bias = model.Bias
+	neuron.Lrate = model.Lrate
+	neuron.Activation = activationByName(model.ActivationName)
+	neuron.Loss = lossByName(model.LossName)
+
+	return nil
+
+}
+
+// MarshalBinary encodes net into its versioned gob wire representation.
+func (net *MLP) MarshalBinary() ([]byte, error) {
+
+	model := MLPModelV1{
+		Magic:   modelMagic,
+		Version: modelVersion1,
+		Layers:  net.layers,
+		Weights: make([][]float64, len(net.weights)),
+		Biases:  make([][]float64, len(net.biases)),
+	}
+
+	for index, weight := range net.weights {
+		model.Weights[index] = append([]float64(nil), weight.RawMatrix().Data...)
+	}
+	for index, bias := range net.biases {
+		model.Biases[index] = append([]float64(nil), bias.RawMatrix().Data...)
+	}
+
+	var buffer bytes.Buffer
+	if err := gob.NewEncoder(&buffer).Encode(model); err != nil {
+		return nil, err
+	}
+
+	return buffer.Bytes(), nil
+
+}
+
+// UnmarshalBinary decodes a versioned gob wire representation produced by MarshalBinary
+// into net, replacing its layers, weights and biases.
+func (net *MLP) UnmarshalBinary(data []byte) error {
+
+	var model MLPModelV1
+	if err := gob.NewDecoder(bytes.NewReader(data)).Decode(&model); err != nil {
+		return err
+	}
+	if model.Magic != modelMagic {
+		return fmt.Errorf("neural: not a go-perceptron-go model, magic mismatch: %q", model.Magic)
+	}
+	if model.Version != modelVersion1 {
+		return fmt.Errorf("neural: unsupported mlp model version: %d", model.Version)
+	}
+
+	rebuilt := NewMLP(model.Layers...)
+	for index := range rebuilt.weights {
+		rows, cols := rebuilt.weights[index].Dims()
+		rebuilt.weights[index] = mat.NewDense(rows, cols, model.Weights[index])
+		biasRows, biasCols := rebuilt.biases[index].Dims()
+		rebuilt.biases[index] = mat.NewDense(biasRows, biasCols, model.Biases[index])
+	}
+
+	*net = *rebuilt
+
+	return nil
+
+}
+
+// SaveModel persists model (a *Neuron or *MLP) to path as a versioned gob payload.
+func SaveModel(path string, model interface{}) error {
+
+	var buffer bytes.Buffer
+	if err := gob.NewEncoder(&buffer).Encode(&model); err != nil {
+		log.WithFields(log.Fields{
+			"level":  "error",
+			"place":  "storage",
+			"func":   "SaveModel",
+			"msg":    "failed to gob-encode model",
+			"path":   path,
+			"errors": err,
+		}).Error("Failed to save model.")
+		return err
+	}
+
+	return os.WriteFile(path, buffer.Bytes(), 0644)
+
+}
+
+// LoadModel reads a versioned gob payload previously written by SaveModel from path and
+// returns the decoded model (a *Neuron or *MLP).
+func LoadModel(path string) (interface{}, error) {
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var model interface{}
+	if err := gob.NewDecoder(bytes.NewReader(data)).Decode(&model); err != nil {
+		log.WithFields(log.Fields{
+			"level":  "error",
+			"place":  "storage",
+			"func":   "LoadModel",
+			"msg":    "failed to gob-decode model",
+			"path":   path,
+			"errors": err,
+		}).Error("Failed to load model.")
+		return nil, err
+	}
+
+	return model, nil
+
+}
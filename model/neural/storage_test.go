@@ -0,0 +1,102 @@
+package neural
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+)
+
+// TestNeuronSaveLoadRoundTrip trains a small neuron, persists it, reloads it into a fresh
+// Neuron value and verifies Predict produces identical outputs.
+func TestNeuronSaveLoadRoundTrip(t *testing.T) {
+
+	stimuli := []Stimulus{
+		{Dimensions: []float64{0.0, 0.0}, Expected: 0.0},
+		{Dimensions: []float64{0.0, 1.0}, Expected: 0.0},
+		{Dimensions: []float64{1.0, 0.0}, Expected: 0.0},
+		{Dimensions: []float64{1.0, 1.0}, Expected: 1.0},
+	}
+
+	trained := Neuron{Lrate: 0.1, Activation: SigmoidActivation{}, Loss: BinaryCrossEntropyLoss{}}
+	TrainNeuron(&trained, stimuli, 50, 1)
+
+	file, err := ioutil.TempFile("", "neuron-model-*.gob")
+	if err != nil {
+		t.Fatalf("failed to create temp file: %v", err)
+	}
+	defer os.Remove(file.Name())
+	file.Close()
+
+	if err := SaveModel(file.Name(), &trained); err != nil {
+		t.Fatalf("SaveModel failed: %v", err)
+	}
+
+	loaded, err := LoadModel(file.Name())
+	if err != nil {
+		t.Fatalf("LoadModel failed: %v", err)
+	}
+
+	reloaded, ok := loaded.(*Neuron)
+	if !ok {
+		t.Fatalf("LoadModel returned %T, expected *Neuron", loaded)
+	}
+
+	if _, ok := reloaded.Loss.(BinaryCrossEntropyLoss); !ok {
+		t.Fatalf("Loss mismatch after round-trip: expected BinaryCrossEntropyLoss, got %T", reloaded.Loss)
+	}
+
+	for _, stimulus := range stimuli {
+		expected := Predict(&trained, &stimulus)
+		actual := Predict(reloaded, &stimulus)
+		if expected != actual {
+			t.Errorf("Predict mismatch after round-trip for %v: expected %v, got %v", stimulus.Dimensions, expected, actual)
+		}
+	}
+
+}
+
+// TestMLPSaveLoadRoundTrip trains a small MLP, persists it, reloads it into a fresh MLP value
+// and verifies Forward produces identical outputs.
+func TestMLPSaveLoadRoundTrip(t *testing.T) {
+
+	stimuli := []Stimulus{
+		{Dimensions: []float64{0.0, 0.0}, Expected: 0.0},
+		{Dimensions: []float64{0.0, 1.0}, Expected: 1.0},
+		{Dimensions: []float64{1.0, 0.0}, Expected: 1.0},
+		{Dimensions: []float64{1.0, 1.0}, Expected: 0.0},
+	}
+
+	trained := NewMLP(2, 4, 1)
+	TrainMLP(trained, stimuli, TrainParams{NumEpochs: 50, MiniBatchSize: 1, LearningRate: 0.5})
+
+	file, err := ioutil.TempFile("", "mlp-model-*.gob")
+	if err != nil {
+		t.Fatalf("failed to create temp file: %v", err)
+	}
+	defer os.Remove(file.Name())
+	file.Close()
+
+	if err := SaveModel(file.Name(), trained); err != nil {
+		t.Fatalf("SaveModel failed: %v", err)
+	}
+
+	loaded, err := LoadModel(file.Name())
+	if err != nil {
+		t.Fatalf("LoadModel failed: %v", err)
+	}
+
+	reloaded, ok := loaded.(*MLP)
+	if !ok {
+		t.Fatalf("LoadModel returned %T, expected *MLP", loaded)
+	}
+
+	for _, stimulus := range stimuli {
+		input := stimulusToColumn(&stimulus)
+		expected := trained.Forward(input)
+		actual := reloaded.Forward(input)
+		if expected.At(0, 0) != actual.At(0, 0) {
+			t.Errorf("Forward mismatch after round-trip for %v: expected %v, got %v", stimulus.Dimensions, expected.At(0, 0), actual.At(0, 0))
+		}
+	}
+
+}
@@ -0,0 +1,38 @@
+// Util provides util to handle common tasks shared across the repo.
+package util
+
+import (
+
+	// third part import
+	log "github.com/sirupsen/logrus"
+)
+
+// ScalarProduct compute scalar product between two float64 based slices.
+// It returns a float64 value.
+func ScalarProduct(a []float64, b []float64) float64 {
+
+	// if slices have different number of elements
+	if len(a) != len(b) {
+		log.WithFields(log.Fields{
+			"level":  "error",
+			"place":  "mixed",
+			"method": "ScalarProduct",
+			"msg":    "scalar product between slices",
+			"aLen":   len(a),
+			"bLen":   len(b),
+		}).Error("Failed to compute scalar product between slices: different length.")
+		return -1.0
+	}
+
+	// init result
+	var result float64 = 0.0
+
+	// for each element compute product
+	for index, value := range a {
+		result = result + (value * b[index])
+	}
+
+	// return value
+	return result
+
+}